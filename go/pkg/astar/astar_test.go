@@ -4,6 +4,8 @@ import (
 	"math"
 	"reflect"
 	"testing"
+
+	"github.com/MrBozkay/graph-algorithms-polyglot/go/pkg/graph"
 )
 
 func TestAStar(t *testing.T) {
@@ -77,3 +79,106 @@ func TestAStarWithHeuristic(t *testing.T) {
 		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
 	}
 }
+
+func TestFindPathWithCSRBackend(t *testing.T) {
+	g := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	h := func(node, goal string) float64 { return 0 }
+
+	result, err := FindPath(graph.NewCSR(g), "A", "D", h)
+	if err != nil {
+		t.Fatalf("FindPath with CSR backend failed: %v", err)
+	}
+
+	if result.Distance != 3 {
+		t.Errorf("Distance: got %v, want 3", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestBidirectionalFindPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	zero := func(node, goal string) float64 { return 0 }
+
+	result, err := BidirectionalFindPath(graph, "A", "D", zero, zero)
+	if err != nil {
+		t.Fatalf("BidirectionalFindPath failed: %v", err)
+	}
+
+	if result.Distance != 3 {
+		t.Errorf("Distance: got %v, want 3", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestBidirectionalFindPathWithHeuristic(t *testing.T) {
+	// Same grid-like graph as TestAStarWithHeuristic, so a nonzero, consistent
+	// Manhattan-distance heuristic exercises the averaged-potential path in
+	// BidirectionalFindPath rather than degenerating to plain bidirectional Dijkstra.
+	coords := map[string][2]float64{
+		"A": {0, 0},
+		"B": {1, 0},
+		"C": {0, 1},
+		"D": {2, 0},
+	}
+
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 1},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	manhattan := func(a, b string) float64 {
+		pa, pb := coords[a], coords[b]
+		return math.Abs(pa[0]-pb[0]) + math.Abs(pa[1]-pb[1])
+	}
+
+	result, err := BidirectionalFindPath(graph, "A", "D", manhattan, manhattan)
+	if err != nil {
+		t.Fatalf("BidirectionalFindPath failed: %v", err)
+	}
+
+	if result.Distance != 2 {
+		t.Errorf("Distance: got %v, want 2", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestBidirectionalFindPathNoPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {},
+		"C": {},
+	}
+
+	zero := func(node, goal string) float64 { return 0 }
+
+	_, err := BidirectionalFindPath(graph, "A", "C", zero, zero)
+	if err == nil {
+		t.Error("Expected error for non-existent path, got nil")
+	}
+}
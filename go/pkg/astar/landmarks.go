@@ -0,0 +1,205 @@
+package astar
+
+import (
+	"encoding/gob"
+	"io"
+	"math"
+	"math/rand"
+
+	"github.com/MrBozkay/graph-algorithms-polyglot/go/pkg/dijkstra"
+)
+
+// LandmarkStrategy selects how landmark vertices are chosen by PrecomputeLandmarks.
+type LandmarkStrategy int
+
+const (
+	// Random selects k landmarks uniformly at random.
+	Random LandmarkStrategy = iota
+	// FarthestFirst greedily selects each next landmark as the vertex that maximizes
+	// the minimum distance to every landmark already chosen, giving good coverage of
+	// the graph at the cost of a Dijkstra run per landmark.
+	FarthestFirst
+	// Avoid is a cheaper approximation of FarthestFirst: each next landmark is the
+	// vertex farthest from only the most recently chosen landmark, rather than the
+	// minimum over all of them, trading some coverage quality for fewer comparisons.
+	Avoid
+)
+
+// Landmarks holds ALT (A*, Landmarks, Triangle inequality) preprocessing data: the
+// distances from and to a set of landmark vertices, computed once via Dijkstra and
+// reused across many later A* queries through Heuristic. Because distances obey the
+// triangle inequality, the resulting heuristic is both admissible and consistent.
+type Landmarks struct {
+	Nodes     []string
+	DistFromL map[string]map[string]float64
+	DistToL   map[string]map[string]float64
+}
+
+// PrecomputeLandmarks selects k landmark vertices from graph using strategy, then runs
+// Dijkstra from each landmark on the forward graph (DistFromL) and on the reversed
+// graph (DistToL) to build the distance tables Heuristic needs.
+func PrecomputeLandmarks(graph Graph, k int, strategy LandmarkStrategy) *Landmarks {
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+
+	landmarks := selectLandmarks(graph, nodes, k, strategy)
+	reverse := reverseGraph(graph)
+
+	distFromL := make(map[string]map[string]float64, len(landmarks))
+	distToL := make(map[string]map[string]float64, len(landmarks))
+
+	for _, l := range landmarks {
+		if forward, err := dijkstra.Dijkstra(graph, l); err == nil {
+			distFromL[l] = forward.Distances
+		}
+		if backward, err := dijkstra.Dijkstra(reverse, l); err == nil {
+			distToL[l] = backward.Distances
+		}
+	}
+
+	return &Landmarks{
+		Nodes:     landmarks,
+		DistFromL: distFromL,
+		DistToL:   distToL,
+	}
+}
+
+func selectLandmarks(graph Graph, nodes []string, k int, strategy LandmarkStrategy) []string {
+	if k > len(nodes) {
+		k = len(nodes)
+	}
+	if k <= 0 || len(nodes) == 0 {
+		return nil
+	}
+
+	switch strategy {
+	case Random:
+		shuffled := append([]string(nil), nodes...)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		return shuffled[:k]
+
+	case Avoid:
+		landmarks := []string{nodes[0]}
+		for len(landmarks) < k {
+			result, err := dijkstra.Dijkstra(graph, landmarks[len(landmarks)-1])
+			if err != nil {
+				break
+			}
+			next := farthestUnselected(nodes, landmarks, result.Distances)
+			if next == "" {
+				break
+			}
+			landmarks = append(landmarks, next)
+		}
+		return landmarks
+
+	default: // FarthestFirst
+		landmarks := []string{nodes[0]}
+		minDist := make(map[string]float64, len(nodes))
+		for _, n := range nodes {
+			minDist[n] = math.Inf(1)
+		}
+
+		for len(landmarks) < k {
+			result, err := dijkstra.Dijkstra(graph, landmarks[len(landmarks)-1])
+			if err != nil {
+				break
+			}
+			for _, n := range nodes {
+				if d := result.Distances[n]; d < minDist[n] {
+					minDist[n] = d
+				}
+			}
+
+			next := farthestUnselected(nodes, landmarks, minDist)
+			if next == "" {
+				break
+			}
+			landmarks = append(landmarks, next)
+		}
+		return landmarks
+	}
+}
+
+func isLandmark(landmarks []string, node string) bool {
+	for _, l := range landmarks {
+		if l == node {
+			return true
+		}
+	}
+	return false
+}
+
+func farthestUnselected(nodes, landmarks []string, distances map[string]float64) string {
+	farthest := ""
+	farthestDist := -1.0
+	fallback := ""
+	for _, n := range nodes {
+		if isLandmark(landmarks, n) {
+			continue
+		}
+		if fallback == "" {
+			fallback = n
+		}
+		if d := distances[n]; !math.IsInf(d, 1) && d > farthestDist {
+			farthestDist = d
+			farthest = n
+		}
+	}
+	if farthest == "" {
+		// No unselected vertex is reachable; take any unselected one so k is still met.
+		return fallback
+	}
+	return farthest
+}
+
+// Heuristic returns a HeuristicFunc for goal backed by l's precomputed distance tables.
+// For a node n it computes the maximum over landmarks l of the signed differences
+// distFromL[l][goal] - distFromL[l][n] and distToL[l][n] - distToL[l][goal] (never less
+// than 0). No absolute value is taken, since on a directed graph that would bound the
+// reverse distance instead. The result is admissible and consistent by the triangle
+// inequality and can be used as a drop-in replacement for a hand-written heuristic in
+// FindPath or BidirectionalFindPath.
+func (l *Landmarks) Heuristic(goal string) HeuristicFunc {
+	return func(node, _ string) float64 {
+		best := 0.0
+		for _, lm := range l.Nodes {
+			if df, ok := l.DistFromL[lm]; ok {
+				if toGoal, ok := df[goal]; ok {
+					if toNode, ok := df[node]; ok {
+						if d := toGoal - toNode; d > best {
+							best = d
+						}
+					}
+				}
+			}
+			if dt, ok := l.DistToL[lm]; ok {
+				if fromNode, ok := dt[node]; ok {
+					if fromGoal, ok := dt[goal]; ok {
+						if d := fromNode - fromGoal; d > best {
+							best = d
+						}
+					}
+				}
+			}
+		}
+		return best
+	}
+}
+
+// SaveLandmarks writes l to w using encoding/gob so preprocessing can be reused across
+// runs instead of recomputed from scratch.
+func SaveLandmarks(w io.Writer, l *Landmarks) error {
+	return gob.NewEncoder(w).Encode(l)
+}
+
+// LoadLandmarks reads a Landmarks value previously written by SaveLandmarks.
+func LoadLandmarks(r io.Reader) (*Landmarks, error) {
+	var l Landmarks
+	if err := gob.NewDecoder(r).Decode(&l); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
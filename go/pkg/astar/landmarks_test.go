@@ -0,0 +1,106 @@
+package astar
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestPrecomputeLandmarksFarthestFirst(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	landmarks := PrecomputeLandmarks(graph, 2, FarthestFirst)
+	if len(landmarks.Nodes) != 2 {
+		t.Fatalf("expected 2 landmarks, got %d", len(landmarks.Nodes))
+	}
+
+	h := landmarks.Heuristic("D")
+	result, err := FindPath(graph, "A", "D", h)
+	if err != nil {
+		t.Fatalf("FindPath with ALT heuristic failed: %v", err)
+	}
+
+	if result.Distance != 3 {
+		t.Errorf("Distance: got %v, want 3", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestPrecomputeLandmarksAvoidAndRandom(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	for _, strategy := range []LandmarkStrategy{Avoid, Random} {
+		landmarks := PrecomputeLandmarks(graph, 2, strategy)
+		if len(landmarks.Nodes) != 2 {
+			t.Fatalf("strategy %v: expected 2 landmarks, got %d", strategy, len(landmarks.Nodes))
+		}
+	}
+}
+
+func TestHeuristicAdmissibleOnAsymmetricGraph(t *testing.T) {
+	// L->G is cheap but G->L doesn't exist, so DistFromL["L"]["G"]=1 is far smaller than
+	// DistFromL["L"]["N"]=10; taking the absolute difference would bound d(G,N) instead
+	// of d(N,G) and overestimate the true remaining cost of 5.
+	graph := Graph{
+		"L": {"G": 1, "N": 10},
+		"N": {"G": 5},
+		"G": {},
+	}
+
+	landmarks := &Landmarks{
+		Nodes:     []string{"L"},
+		DistFromL: map[string]map[string]float64{"L": {"L": 0, "G": 1, "N": 10}},
+	}
+
+	truth, err := FindPath(graph, "N", "G", zeroHeuristic)
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+
+	h := landmarks.Heuristic("G")
+	if estimate := h("N", "G"); estimate > truth.Distance {
+		t.Errorf("Heuristic(\"G\")(\"N\", \"G\") = %v, want <= true distance %v (admissibility violated)", estimate, truth.Distance)
+	}
+}
+
+func TestLandmarksSerializationRoundTrip(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	landmarks := PrecomputeLandmarks(graph, 2, FarthestFirst)
+
+	var buf bytes.Buffer
+	if err := SaveLandmarks(&buf, landmarks); err != nil {
+		t.Fatalf("SaveLandmarks failed: %v", err)
+	}
+
+	loaded, err := LoadLandmarks(&buf)
+	if err != nil {
+		t.Fatalf("LoadLandmarks failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(landmarks.Nodes, loaded.Nodes) {
+		t.Errorf("Nodes: got %v, want %v", loaded.Nodes, landmarks.Nodes)
+	}
+	if !reflect.DeepEqual(landmarks.DistFromL, loaded.DistFromL) {
+		t.Errorf("DistFromL: got %v, want %v", loaded.DistFromL, landmarks.DistFromL)
+	}
+}
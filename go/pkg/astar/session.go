@@ -0,0 +1,127 @@
+package astar
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// itemPool recycles priorityQueueItem allocations across Session queries, motivated by
+// the pooling redesign used in some game A*/Dijkstra forks to avoid GC pressure from
+// per-call heap allocations.
+var itemPool = sync.Pool{
+	New: func() interface{} { return new(priorityQueueItem) },
+}
+
+// Session owns pre-allocated search state (a priority queue, g-score/predecessor
+// slices, and a node index) that FindPath reuses across repeated queries instead of
+// allocating fresh maps and heaps each call. This targets routing servers and games
+// that run many path queries per second against the same graph.
+type Session struct {
+	nodes        []string
+	index        map[string]int
+	gScore       []float64
+	predecessors []int32
+	visited      []bool
+	pq           priorityQueue
+}
+
+// NewSession creates a Session indexed over graph's current node set. Reuse one Session
+// per graph across queries; if the graph's node set changes, create a new Session.
+func NewSession(graph Graph) *Session {
+	s := &Session{index: make(map[string]int, len(graph))}
+	for node := range graph {
+		s.index[node] = len(s.nodes)
+		s.nodes = append(s.nodes, node)
+	}
+
+	n := len(s.nodes)
+	s.gScore = make([]float64, n)
+	s.predecessors = make([]int32, n)
+	s.visited = make([]bool, n)
+	return s
+}
+
+// reset clears the session's per-query state in place and returns any leftover queue
+// items to itemPool, instead of allocating fresh slices and a fresh heap
+func (s *Session) reset() {
+	for i := range s.gScore {
+		s.gScore[i] = math.Inf(1)
+		s.predecessors[i] = -1
+		s.visited[i] = false
+	}
+	for _, item := range s.pq {
+		itemPool.Put(item)
+	}
+	s.pq = s.pq[:0]
+}
+
+func (s *Session) pushItem(node string, gScore, fScore float64) {
+	item := itemPool.Get().(*priorityQueueItem)
+	item.node = node
+	item.gScore = gScore
+	item.fScore = fScore
+	item.index = 0
+	heap.Push(&s.pq, item)
+}
+
+// FindPath finds the shortest path from start to goal using A*, reusing this session's
+// pre-allocated g-score/predecessor slices and pooled priority queue items instead of
+// allocating fresh ones for every query.
+func (s *Session) FindPath(graph Graph, start, goal string, h HeuristicFunc) (*Result, error) {
+	s.reset()
+
+	startIdx, ok := s.index[start]
+	if !ok {
+		return nil, fmt.Errorf("start node %s not found in graph", start)
+	}
+	if _, ok := s.index[goal]; !ok {
+		return nil, fmt.Errorf("goal node %s not found in graph", goal)
+	}
+	s.gScore[startIdx] = 0
+	s.pushItem(start, 0, h(start, goal))
+
+	for s.pq.Len() > 0 {
+		current := heap.Pop(&s.pq).(*priorityQueueItem)
+		ci := s.index[current.node]
+
+		if current.node == goal {
+			path := []string{goal}
+			curr := ci
+			for curr != startIdx {
+				if s.predecessors[curr] == -1 {
+					itemPool.Put(current)
+					return nil, fmt.Errorf("broken path reconstruction")
+				}
+				curr = int(s.predecessors[curr])
+				path = append([]string{s.nodes[curr]}, path...)
+			}
+			itemPool.Put(current)
+			return &Result{Distance: s.gScore[ci], Path: path}, nil
+		}
+
+		if s.visited[ci] {
+			itemPool.Put(current)
+			continue
+		}
+		s.visited[ci] = true
+
+		for neighbor, weight := range graph[current.node] {
+			ni, ok := s.index[neighbor]
+			if !ok {
+				continue
+			}
+			tentativeG := s.gScore[ci] + weight
+			if tentativeG < s.gScore[ni] {
+				s.predecessors[ni] = int32(ci)
+				s.gScore[ni] = tentativeG
+				s.pushItem(neighbor, tentativeG, tentativeG+h(neighbor, goal))
+			}
+		}
+
+		itemPool.Put(current)
+	}
+
+	return nil, fmt.Errorf("no path found from %s to %s", start, goal)
+}
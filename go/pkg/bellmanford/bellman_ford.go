@@ -3,10 +3,14 @@ package bellmanford
 import (
 	"fmt"
 	"math"
+
+	"github.com/MrBozkay/graph-algorithms-polyglot/go/pkg/graph"
 )
 
-// Graph represents a graph where edges have weights
-type Graph map[string]map[string]float64
+// Graph represents a graph where edges have weights. It is a type alias for graph.Map
+// so existing callers keep working unchanged while Run itself operates on the
+// backend-agnostic graph.Graph interface.
+type Graph = graph.Map
 
 // Result contains the shortest distances and predecessors
 type Result struct {
@@ -20,39 +24,60 @@ type CheckNegativeCycleResult struct {
 	Cycle    []string
 }
 
-// Run executes the Bellman-Ford algorithm
-// Returns error if a negative cycle is detected
-func Run(graph Graph, start string) (*Result, error) {
-	distances := make(map[string]float64)
-	predecessors := make(map[string]*string)
+// Run executes the Bellman-Ford algorithm. It accepts any graph.Graph backend
+// (graph.Map or graph.CSR) and does its relaxation sweeps over a flat edge list with
+// dense int-indexed distance/predecessor slices rather than per-node maps. Index
+// lookups go through graph.Index so a CSR backend's native O(1) index is used directly
+// instead of being shadowed by a second, redundant name->index map.
+// Returns error if a negative cycle is detected.
+//
+// Time Complexity: O(V*E)
+// Space Complexity: O(V + E)
+func Run(g graph.Graph, start string) (*Result, error) {
+	nodes, index := graph.Index(g)
+	n := len(nodes)
 
-	// Initialize
-	for node := range graph {
-		distances[node] = math.Inf(1)
-		predecessors[node] = nil
+	distances := make([]float64, n)
+	predecessors := make([]int32, n)
+	for i := range distances {
+		distances[i] = math.Inf(1)
+		predecessors[i] = -1
 	}
-	distances[start] = 0
 
-	// Get all nodes for iteration
-	nodes := make([]string, 0, len(graph))
-	for node := range graph {
-		nodes = append(nodes, node)
+	startIdx := index(start)
+	if startIdx < 0 {
+		return nil, fmt.Errorf("start node %s not found in graph", start)
+	}
+	distances[startIdx] = 0
+
+	// Flatten the graph into an edge list once, so each relaxation sweep is a simple
+	// slice scan instead of re-walking every node's neighbor map.
+	type edge struct {
+		u, v int
+		w    float64
+	}
+	edges := make([]edge, 0)
+	for i, node := range nodes {
+		for neighbor, weight := range g.Neighbors(node) {
+			vi := index(neighbor)
+			if vi < 0 {
+				continue
+			}
+			edges = append(edges, edge{u: i, v: vi, w: weight})
+		}
 	}
 
 	// Relax edges |V| - 1 times
-	for i := 0; i < len(nodes)-1; i++ {
+	for i := 0; i < n-1; i++ {
 		updated := false
-		for u := range graph {
-			if math.IsInf(distances[u], 1) {
+		for _, e := range edges {
+			if math.IsInf(distances[e.u], 1) {
 				continue
 			}
-			for v, weight := range graph[u] {
-				if distances[u]+weight < distances[v] {
-					distances[v] = distances[u] + weight
-					curr := u
-					predecessors[v] = &curr
-					updated = true
-				}
+			if distances[e.u]+e.w < distances[e.v] {
+				distances[e.v] = distances[e.u] + e.w
+				predecessors[e.v] = int32(e.u)
+				updated = true
 			}
 		}
 		if !updated {
@@ -61,13 +86,129 @@ func Run(graph Graph, start string) (*Result, error) {
 	}
 
 	// Check for negative cycles
-	for u := range graph {
-		if math.IsInf(distances[u], 1) {
+	for _, e := range edges {
+		if math.IsInf(distances[e.u], 1) {
 			continue
 		}
+		if distances[e.u]+e.w < distances[e.v] {
+			return nil, fmt.Errorf("negative cycle detected")
+		}
+	}
+
+	resultDistances := make(map[string]float64, n)
+	resultPredecessors := make(map[string]*string, n)
+	for i, node := range nodes {
+		resultDistances[node] = distances[i]
+		if predecessors[i] == -1 {
+			resultPredecessors[node] = nil
+		} else {
+			pred := nodes[predecessors[i]]
+			resultPredecessors[node] = &pred
+		}
+	}
+
+	return &Result{
+		Distances:    resultDistances,
+		Predecessors: resultPredecessors,
+	}, nil
+}
+
+// CandidateOrder controls how newly-relaxed vertices are queued by RunSPFA.
+type CandidateOrder int
+
+const (
+	// Naive always pushes a relaxed vertex to the back of the queue.
+	Naive CandidateOrder = iota
+	// SmallLabelFirst pushes a relaxed vertex to the front of the queue when its new
+	// distance is smaller than the distance of the vertex currently at the front.
+	SmallLabelFirst
+	// LargeLabelLast rotates vertices whose distance exceeds the queue's running average
+	// to the back before they are processed.
+	LargeLabelLast
+)
+
+// RunSPFA executes the Shortest Path Faster Algorithm, a queue-based reformulation of
+// Bellman-Ford: rather than sweeping every edge |V|-1 times, it maintains a deque of
+// "dirty" vertices whose distance was just improved and only re-examines their
+// neighbors. A vertex relaxed more than |V| times indicates a negative cycle. order
+// selects how candidates are inserted into the deque; see CandidateOrder.
+//
+// Time Complexity: O(V*E) worst case, typically much faster on sparse graphs
+// Space Complexity: O(V)
+func RunSPFA(graph Graph, start string, order CandidateOrder) (*Result, error) {
+	distances := make(map[string]float64)
+	predecessors := make(map[string]*string)
+	inQueue := make(map[string]bool)
+	relaxCount := make(map[string]int)
+
+	numNodes := len(graph)
+	for node := range graph {
+		distances[node] = math.Inf(1)
+		predecessors[node] = nil
+	}
+	distances[start] = 0
+
+	queue := []string{start}
+	inQueue[start] = true
+	sum := distances[start]
+	count := 1
+
+	for len(queue) > 0 {
+		var u string
+		if order == LargeLabelLast {
+			for {
+				u = queue[0]
+				queue = queue[1:]
+				if len(queue) > 0 && count > 0 && distances[u] > sum/float64(count) {
+					queue = append(queue, u)
+					continue
+				}
+				break
+			}
+		} else {
+			u = queue[0]
+			queue = queue[1:]
+		}
+
+		inQueue[u] = false
+		sum -= distances[u]
+		count--
+
 		for v, weight := range graph[u] {
-			if distances[u]+weight < distances[v] {
-				return nil, fmt.Errorf("negative cycle detected")
+			newDist := distances[u] + weight
+			if newDist < distances[v] {
+				if inQueue[v] {
+					// v is still in the queue with its old distance counted in sum;
+					// swap the stale contribution for newDist so sum/count keeps
+					// reflecting exactly the vertices currently queued.
+					sum -= distances[v]
+					sum += newDist
+				}
+				distances[v] = newDist
+				pred := u
+				predecessors[v] = &pred
+
+				relaxCount[v]++
+				if relaxCount[v] > numNodes {
+					return nil, fmt.Errorf("negative cycle detected")
+				}
+
+				if !inQueue[v] {
+					inQueue[v] = true
+					sum += newDist
+					count++
+
+					switch order {
+					case SmallLabelFirst:
+						if len(queue) > 0 && newDist < distances[queue[0]] {
+							queue = append([]string{v}, queue...)
+						} else {
+							queue = append(queue, v)
+						}
+					default:
+						queue = append(queue, v)
+					}
+				}
 			}
 		}
 	}
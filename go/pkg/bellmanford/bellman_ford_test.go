@@ -2,6 +2,8 @@ package bellmanford
 
 import (
 	"testing"
+
+	"github.com/MrBozkay/graph-algorithms-polyglot/go/pkg/graph"
 )
 
 func TestBellmanFord(t *testing.T) {
@@ -31,6 +33,107 @@ func TestBellmanFord(t *testing.T) {
 	}
 }
 
+func TestRunWithCSRBackend(t *testing.T) {
+	g := Graph{
+		"A": {"B": -1, "C": 4},
+		"B": {"C": 3, "D": 2},
+		"C": {},
+		"D": {"B": 1, "C": 5},
+	}
+
+	result, err := Run(graph.NewCSR(g), "A")
+	if err != nil {
+		t.Fatalf("Run with CSR backend failed: %v", err)
+	}
+
+	expectedDistances := map[string]float64{
+		"A": 0,
+		"B": -1,
+		"C": 2,
+		"D": 1,
+	}
+
+	for node, expected := range expectedDistances {
+		if got := result.Distances[node]; got != expected {
+			t.Errorf("Distance for %s: got %v, want %v", node, got, expected)
+		}
+	}
+}
+
+func TestRunSPFA(t *testing.T) {
+	graph := Graph{
+		"A": {"B": -1, "C": 4},
+		"B": {"C": 3, "D": 2},
+		"C": {},
+		"D": {"B": 1, "C": 5},
+	}
+
+	expectedDistances := map[string]float64{
+		"A": 0,
+		"B": -1,
+		"C": 2,
+		"D": 1,
+	}
+
+	for _, order := range []CandidateOrder{Naive, SmallLabelFirst, LargeLabelLast} {
+		result, err := RunSPFA(graph, "A", order)
+		if err != nil {
+			t.Fatalf("RunSPFA failed for order %v: %v", order, err)
+		}
+
+		for node, expected := range expectedDistances {
+			if got := result.Distances[node]; got != expected {
+				t.Errorf("order %v: distance for %s: got %v, want %v", order, node, got, expected)
+			}
+		}
+	}
+}
+
+func TestRunSPFALargeLabelLastRequeuedVertex(t *testing.T) {
+	// B is first queued via A->B with a large weight, then relaxed again to a much
+	// smaller distance via A->C->D->B while still sitting in the queue, so the
+	// LargeLabelLast running sum/count must track that second relaxation correctly.
+	graph := Graph{
+		"A": {"B": 10, "C": 1},
+		"B": {"E": 1},
+		"C": {"D": 1},
+		"D": {"B": 1},
+		"E": {},
+	}
+
+	expectedDistances := map[string]float64{
+		"A": 0,
+		"B": 3,
+		"C": 1,
+		"D": 2,
+		"E": 4,
+	}
+
+	result, err := RunSPFA(graph, "A", LargeLabelLast)
+	if err != nil {
+		t.Fatalf("RunSPFA failed: %v", err)
+	}
+
+	for node, expected := range expectedDistances {
+		if got := result.Distances[node]; got != expected {
+			t.Errorf("distance for %s: got %v, want %v", node, got, expected)
+		}
+	}
+}
+
+func TestRunSPFANegativeCycle(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {"C": -3},
+		"C": {"A": 1},
+	}
+
+	_, err := RunSPFA(graph, "A", Naive)
+	if err == nil {
+		t.Fatal("Expected error for negative cycle, got nil")
+	}
+}
+
 func TestNegativeCycle(t *testing.T) {
 	graph := Graph{
 		"A": {"B": 1},
@@ -3,6 +3,8 @@ package dijkstra
 import (
 	"reflect"
 	"testing"
+
+	"github.com/MrBozkay/graph-algorithms-polyglot/go/pkg/graph"
 )
 
 func TestDijkstra(t *testing.T) {
@@ -67,3 +69,66 @@ func TestNoPath(t *testing.T) {
 		t.Error("Expected error for non-existent path, got nil")
 	}
 }
+
+func TestDijkstraWithCSRBackend(t *testing.T) {
+	g := Graph{
+		"A": {"B": 4, "C": 2},
+		"B": {"C": 1, "D": 5},
+		"C": {"D": 8},
+		"D": {},
+	}
+
+	result, err := Dijkstra(graph.NewCSR(g), "A")
+	if err != nil {
+		t.Fatalf("Dijkstra with CSR backend failed: %v", err)
+	}
+
+	expectedDistances := map[string]float64{
+		"A": 0,
+		"B": 4,
+		"C": 2,
+		"D": 9,
+	}
+
+	for node, expected := range expectedDistances {
+		if got := result.Distances[node]; got != expected {
+			t.Errorf("Distance for %s: got %v, want %v", node, got, expected)
+		}
+	}
+}
+
+func TestBidirectionalFindPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"C": 2, "D": 5},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	result, err := BidirectionalFindPath(graph, "A", "D")
+	if err != nil {
+		t.Fatalf("BidirectionalFindPath failed: %v", err)
+	}
+
+	if result.Distance != 4 {
+		t.Errorf("Path distance: got %v, want 4", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "C", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestBidirectionalFindPathNoPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {},
+		"C": {},
+	}
+
+	_, err := BidirectionalFindPath(graph, "A", "C")
+	if err == nil {
+		t.Error("Expected error for non-existent path, got nil")
+	}
+}
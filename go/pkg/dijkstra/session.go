@@ -0,0 +1,139 @@
+package dijkstra
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// itemPool recycles priorityQueueItem allocations across Session queries, motivated by
+// the pooling redesign used in some game A*/Dijkstra forks to avoid GC pressure from
+// per-call heap allocations.
+var itemPool = sync.Pool{
+	New: func() interface{} { return new(priorityQueueItem) },
+}
+
+// Session owns pre-allocated search state (a priority queue, distance/predecessor
+// slices, and a node index) that FindPath reuses across repeated queries instead of
+// allocating fresh maps and heaps each call. This targets routing servers and games
+// that run many path queries per second against the same graph.
+type Session struct {
+	nodes        []string
+	index        map[string]int
+	distances    []float64
+	predecessors []int32
+	visited      []bool
+	pq           priorityQueue
+}
+
+// NewSession creates a Session indexed over graph's current node set. Reuse one Session
+// per graph across queries; if the graph's node set changes, create a new Session.
+func NewSession(graph Graph) *Session {
+	s := &Session{index: make(map[string]int, len(graph))}
+	for node := range graph {
+		s.index[node] = len(s.nodes)
+		s.nodes = append(s.nodes, node)
+	}
+
+	n := len(s.nodes)
+	s.distances = make([]float64, n)
+	s.predecessors = make([]int32, n)
+	s.visited = make([]bool, n)
+	return s
+}
+
+// reset clears the session's per-query state in place and returns any leftover queue
+// items to itemPool, instead of allocating fresh slices and a fresh heap
+func (s *Session) reset() {
+	for i := range s.distances {
+		s.distances[i] = math.Inf(1)
+		s.predecessors[i] = -1
+		s.visited[i] = false
+	}
+	for _, item := range s.pq {
+		itemPool.Put(item)
+	}
+	s.pq = s.pq[:0]
+}
+
+func (s *Session) pushItem(node string, distance float64) {
+	item := itemPool.Get().(*priorityQueueItem)
+	item.node = node
+	item.distance = distance
+	item.index = 0
+	heap.Push(&s.pq, item)
+}
+
+// FindPath finds the shortest path between start and end, reusing this session's
+// pre-allocated distance/predecessor slices and pooled priority queue items instead of
+// allocating fresh ones for every query.
+func (s *Session) FindPath(graph Graph, start, end string) (*PathResult, error) {
+	s.reset()
+
+	startIdx, ok := s.index[start]
+	if !ok {
+		return nil, fmt.Errorf("start node %s not found in graph", start)
+	}
+	endIdx, ok := s.index[end]
+	if !ok {
+		return nil, fmt.Errorf("end node %s not found in graph", end)
+	}
+	s.distances[startIdx] = 0
+	s.pushItem(start, 0)
+
+	for s.pq.Len() > 0 {
+		current := heap.Pop(&s.pq).(*priorityQueueItem)
+		ci := s.index[current.node]
+
+		if current.node == end {
+			itemPool.Put(current)
+			break
+		}
+
+		if s.visited[ci] {
+			itemPool.Put(current)
+			continue
+		}
+		s.visited[ci] = true
+
+		if current.distance > s.distances[ci] {
+			itemPool.Put(current)
+			continue
+		}
+
+		for neighbor, weight := range graph[current.node] {
+			ni, ok := s.index[neighbor]
+			if !ok {
+				continue
+			}
+			distance := current.distance + weight
+			if distance < s.distances[ni] {
+				s.distances[ni] = distance
+				s.predecessors[ni] = int32(ci)
+				s.pushItem(neighbor, distance)
+			}
+		}
+
+		itemPool.Put(current)
+	}
+
+	if math.IsInf(s.distances[endIdx], 1) {
+		return nil, fmt.Errorf("no path exists from %s to %s", start, end)
+	}
+
+	path := []string{end}
+	curr := endIdx
+	for curr != startIdx {
+		if s.predecessors[curr] == -1 {
+			return nil, fmt.Errorf("broken path reconstruction")
+		}
+		curr = int(s.predecessors[curr])
+		path = append([]string{s.nodes[curr]}, path...)
+	}
+
+	return &PathResult{
+		Distance: s.distances[endIdx],
+		Path:     path,
+	}, nil
+}
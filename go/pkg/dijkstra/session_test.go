@@ -0,0 +1,111 @@
+package dijkstra
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSessionFindPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 5},
+		"B": {"C": 1, "D": 4},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	session := NewSession(graph)
+
+	result, err := session.FindPath(graph, "A", "D")
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+
+	if result.Distance != 3 {
+		t.Errorf("Distance: got %v, want 3", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "C", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestSessionFindPathRepeatedQueries(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 5},
+		"B": {"C": 1, "D": 4},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	session := NewSession(graph)
+
+	for i := 0; i < 3; i++ {
+		result, err := session.FindPath(graph, "A", "D")
+		if err != nil {
+			t.Fatalf("query %d: FindPath failed: %v", i, err)
+		}
+		if result.Distance != 3 {
+			t.Errorf("query %d: Distance: got %v, want 3", i, result.Distance)
+		}
+	}
+
+	result, err := session.FindPath(graph, "B", "D")
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+	if result.Distance != 2 {
+		t.Errorf("Distance: got %v, want 2", result.Distance)
+	}
+}
+
+func TestSessionFindPathNoPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {},
+		"C": {},
+	}
+
+	session := NewSession(graph)
+	if _, err := session.FindPath(graph, "A", "C"); err == nil {
+		t.Error("Expected error for non-existent path, got nil")
+	}
+}
+
+func buildBenchmarkGraph(n int) Graph {
+	graph := make(Graph, n)
+	for i := 0; i < n; i++ {
+		node := fmt.Sprintf("n%d", i)
+		edges := make(map[string]float64, 2)
+		if i+1 < n {
+			edges[fmt.Sprintf("n%d", i+1)] = 1
+		}
+		if i+2 < n {
+			edges[fmt.Sprintf("n%d", i+2)] = 2
+		}
+		graph[node] = edges
+	}
+	return graph
+}
+
+func BenchmarkFindPath(b *testing.B) {
+	graph := buildBenchmarkGraph(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := FindPath(graph, "n0", "n499"); err != nil {
+			b.Fatalf("FindPath failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkSessionFindPath(b *testing.B) {
+	graph := buildBenchmarkGraph(500)
+	session := NewSession(graph)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := session.FindPath(graph, "n0", "n499"); err != nil {
+			b.Fatalf("FindPath failed: %v", err)
+		}
+	}
+}
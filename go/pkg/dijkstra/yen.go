@@ -0,0 +1,161 @@
+package dijkstra
+
+import (
+	"container/heap"
+	"strings"
+)
+
+// candidateQueue is a min-heap of PathResult candidates ordered by Distance, used by
+// KShortestPaths to always expand the cheapest unexplored candidate next.
+type candidateQueue []*PathResult
+
+func (q candidateQueue) Len() int { return len(q) }
+
+func (q candidateQueue) Less(i, j int) bool { return q[i].Distance < q[j].Distance }
+
+func (q candidateQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *candidateQueue) Push(x interface{}) { *q = append(*q, x.(*PathResult)) }
+func (q *candidateQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[0 : n-1]
+	return item
+}
+
+// pathKey returns a value suitable for deduplicating paths in a set
+func pathKey(path []string) string {
+	return strings.Join(path, ",")
+}
+
+// pathCost sums the edge weights along path using graph, returning ok=false if any edge
+// is missing
+func pathCost(graph Graph, path []string) (float64, bool) {
+	total := 0.0
+	for i := 0; i+1 < len(path); i++ {
+		weight, ok := graph[path[i]][path[i+1]]
+		if !ok {
+			return 0, false
+		}
+		total += weight
+	}
+	return total, true
+}
+
+// KShortestPaths returns up to k loopless shortest paths from start to end using Yen's
+// algorithm, built on top of FindPath. The first path is the plain shortest path; each
+// subsequent path is found by, for every node along the previous path (the spur node),
+// removing the edges and nodes that would reproduce an already-found path sharing that
+// prefix and re-running FindPath from the spur node. Candidates are kept in a min-heap
+// keyed by total cost so the next path returned is always the cheapest one not yet
+// emitted. Returns fewer than k paths if the candidate heap empties first.
+//
+// This operates directly on graph.Map rather than the graph.Graph interface: the spur
+// search works by temporarily deleting edges and nodes from graph and restoring them
+// afterward, which graph.Graph has no mutation method for. It does not run against
+// graph.CSR; supporting that backend would need either a mutable CSR or a "forbidden
+// nodes/edges" filter threaded through Dijkstra itself.
+func KShortestPaths(graph Graph, start, end string, k int) ([]*PathResult, error) {
+	first, err := FindPath(graph, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if k <= 0 {
+		return nil, nil
+	}
+
+	paths := []*PathResult{first}
+	seen := map[string]bool{pathKey(first.Path): true}
+	candidateSeen := map[string]bool{pathKey(first.Path): true}
+
+	candidates := make(candidateQueue, 0)
+	heap.Init(&candidates)
+
+	for len(paths) < k {
+		prev := paths[len(paths)-1]
+
+		for i := 0; i < len(prev.Path)-1; i++ {
+			spurNode := prev.Path[i]
+			rootPath := prev.Path[:i+1]
+
+			rootCost, ok := pathCost(graph, rootPath)
+			if !ok {
+				continue
+			}
+
+			// Remove edges that any already-found path sharing this rootPath would
+			// take out of spurNode, so the spur search can't just reproduce it.
+			removedEdges := make(map[[2]string]float64)
+			for _, p := range paths {
+				if len(p.Path) <= i+1 {
+					continue
+				}
+				match := true
+				for j := 0; j <= i; j++ {
+					if p.Path[j] != rootPath[j] {
+						match = false
+						break
+					}
+				}
+				if !match {
+					continue
+				}
+				u, v := p.Path[i], p.Path[i+1]
+				if w, ok := graph[u][v]; ok {
+					removedEdges[[2]string{u, v}] = w
+					delete(graph[u], v)
+				}
+			}
+
+			// Remove every node in rootPath except spurNode itself, so the spur
+			// search can't loop back through the path already taken to reach it.
+			removedNodes := make(map[string]map[string]float64)
+			for _, node := range rootPath[:len(rootPath)-1] {
+				if edges, ok := graph[node]; ok {
+					removedNodes[node] = edges
+					delete(graph, node)
+				}
+			}
+
+			spur, err := FindPath(graph, spurNode, end)
+			if err == nil {
+				totalPath := append(append([]string{}, rootPath[:len(rootPath)-1]...), spur.Path...)
+				candidate := &PathResult{
+					Distance: rootCost + spur.Distance,
+					Path:     totalPath,
+				}
+				key := pathKey(candidate.Path)
+				if !candidateSeen[key] {
+					candidateSeen[key] = true
+					heap.Push(&candidates, candidate)
+				}
+			}
+
+			// Undo the removals before moving on to the next spur node.
+			for node, edges := range removedNodes {
+				graph[node] = edges
+			}
+			for e, w := range removedEdges {
+				graph[e[0]][e[1]] = w
+			}
+		}
+
+		var next *PathResult
+		for candidates.Len() > 0 {
+			candidate := heap.Pop(&candidates).(*PathResult)
+			if !seen[pathKey(candidate.Path)] {
+				next = candidate
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+
+		seen[pathKey(next.Path)] = true
+		paths = append(paths, next)
+	}
+
+	return paths, nil
+}
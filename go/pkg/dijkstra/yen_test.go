@@ -0,0 +1,66 @@
+package dijkstra
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKShortestPaths(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 5},
+		"B": {"C": 1, "D": 4},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	results, err := KShortestPaths(graph, "A", "D", 3)
+	if err != nil {
+		t.Fatalf("KShortestPaths failed: %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 paths, got %d", len(results))
+	}
+
+	expected := []*PathResult{
+		{Distance: 3, Path: []string{"A", "B", "C", "D"}},
+		{Distance: 5, Path: []string{"A", "B", "D"}},
+		{Distance: 6, Path: []string{"A", "C", "D"}},
+	}
+
+	for i, want := range expected {
+		if results[i].Distance != want.Distance || !reflect.DeepEqual(results[i].Path, want.Path) {
+			t.Errorf("path %d: got {%v %v}, want {%v %v}", i, results[i].Distance, results[i].Path, want.Distance, want.Path)
+		}
+	}
+}
+
+func TestKShortestPathsFewerThanK(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {"C": 1},
+		"C": {},
+	}
+
+	results, err := KShortestPaths(graph, "A", "C", 5)
+	if err != nil {
+		t.Fatalf("KShortestPaths failed: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 path (no alternatives exist), got %d", len(results))
+	}
+}
+
+func TestKShortestPathsNoPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {},
+		"C": {},
+	}
+
+	_, err := KShortestPaths(graph, "A", "C", 2)
+	if err == nil {
+		t.Error("Expected error for non-existent path, got nil")
+	}
+}
@@ -0,0 +1,319 @@
+// Package dstarlite implements the D*-Lite incremental replanning algorithm for agents
+// that repeatedly query shortest paths on a graph whose edge weights change between
+// queries, such as an agent discovering cost changes as it moves through a partially
+// known environment
+//
+// Graph here is a plain adjacency-list map, not the graph.Graph interface used by
+// dijkstra/astar/bellmanford: UpdateEdge mutates Planner.graph in place and
+// reverseGraph rebuilds a full reversed map on every New, neither of which graph.Graph
+// supports. It does not run against graph.CSR.
+package dstarlite
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+)
+
+// Graph represents a weighted directed graph using an adjacency list
+type Graph map[string]map[string]float64
+
+// HeuristicFunc estimates the cost between two nodes
+type HeuristicFunc func(a, b string) float64
+
+// Result contains the path and total cost of the most recently computed plan
+type Result struct {
+	Distance float64
+	Path     []string
+}
+
+// key is the two-component priority used to order the D*-Lite queue
+type key struct {
+	k1 float64
+	k2 float64
+}
+
+func (k key) less(other key) bool {
+	if k.k1 != other.k1 {
+		return k.k1 < other.k1
+	}
+	return k.k2 < other.k2
+}
+
+// queueItem represents an item in the D*-Lite priority queue
+type queueItem struct {
+	node  string
+	key   key
+	index int
+}
+
+// priorityQueue implements heap.Interface
+type priorityQueue []*queueItem
+
+func (pq priorityQueue) Len() int { return len(pq) }
+
+func (pq priorityQueue) Less(i, j int) bool {
+	return pq[i].key.less(pq[j].key)
+}
+
+func (pq priorityQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].index = i
+	pq[j].index = j
+}
+
+func (pq *priorityQueue) Push(x interface{}) {
+	n := len(*pq)
+	item := x.(*queueItem)
+	item.index = n
+	*pq = append(*pq, item)
+}
+
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*pq = old[0 : n-1]
+	return item
+}
+
+// reverseGraph returns a new Graph with every edge direction reversed
+func reverseGraph(graph Graph) Graph {
+	reverse := make(Graph, len(graph))
+	for node := range graph {
+		if _, ok := reverse[node]; !ok {
+			reverse[node] = make(map[string]float64)
+		}
+	}
+	for u, edges := range graph {
+		for v, w := range edges {
+			if _, ok := reverse[v]; !ok {
+				reverse[v] = make(map[string]float64)
+			}
+			reverse[v][u] = w
+		}
+	}
+	return reverse
+}
+
+// Planner incrementally plans and replans shortest paths from a moving start to a fixed
+// goal using D*-Lite. The search runs backward from goal, maintaining g (the current
+// best cost estimate) and rhs (a one-step lookahead cost) for every node; a node is
+// "locally consistent" when g == rhs. UpdateEdge and SetStart only re-enqueue the nodes
+// whose estimates may have changed, so Replan is dramatically cheaper than planning
+// from scratch.
+type Planner struct {
+	graph   Graph
+	reverse Graph
+	h       HeuristicFunc
+	start   string
+	goal    string
+	last    string
+	km      float64
+	g       map[string]float64
+	rhs     map[string]float64
+	queue   priorityQueue
+	inQueue map[string]*queueItem
+}
+
+// New creates a Planner rooted at start and targeting goal. h estimates the remaining
+// cost from a node to the current start and should be admissible for the search to
+// behave as expected.
+func New(graph Graph, start, goal string, h HeuristicFunc) *Planner {
+	p := &Planner{
+		graph:   graph,
+		reverse: reverseGraph(graph),
+		h:       h,
+		start:   start,
+		goal:    goal,
+		last:    start,
+		g:       make(map[string]float64),
+		rhs:     make(map[string]float64),
+		inQueue: make(map[string]*queueItem),
+	}
+
+	for node := range graph {
+		p.g[node] = math.Inf(1)
+		p.rhs[node] = math.Inf(1)
+	}
+	p.rhs[goal] = 0
+
+	heap.Init(&p.queue)
+	p.push(goal, p.calculateKey(goal))
+
+	return p
+}
+
+func (p *Planner) calculateKey(node string) key {
+	m := math.Min(p.g[node], p.rhs[node])
+	return key{k1: m + p.h(node, p.start) + p.km, k2: m}
+}
+
+func (p *Planner) push(node string, k key) {
+	if item, ok := p.inQueue[node]; ok {
+		item.key = k
+		heap.Fix(&p.queue, item.index)
+		return
+	}
+	item := &queueItem{node: node, key: k}
+	heap.Push(&p.queue, item)
+	p.inQueue[node] = item
+}
+
+func (p *Planner) remove(node string) {
+	if item, ok := p.inQueue[node]; ok {
+		heap.Remove(&p.queue, item.index)
+		delete(p.inQueue, node)
+	}
+}
+
+func (p *Planner) top() (string, key, bool) {
+	if p.queue.Len() == 0 {
+		return "", key{}, false
+	}
+	item := p.queue[0]
+	return item.node, item.key, true
+}
+
+// updateVertex recomputes rhs(u) from its successors and fixes its queue membership so
+// only nodes that are not yet locally consistent remain queued
+func (p *Planner) updateVertex(u string) {
+	if u != p.goal {
+		best := math.Inf(1)
+		for v, w := range p.graph[u] {
+			if c := w + p.g[v]; c < best {
+				best = c
+			}
+		}
+		p.rhs[u] = best
+	}
+
+	p.remove(u)
+	if p.g[u] != p.rhs[u] {
+		p.push(u, p.calculateKey(u))
+	}
+}
+
+// computeShortestPath drains the queue until start is locally consistent and its key no
+// longer exceeds the minimum key remaining in the queue
+func (p *Planner) computeShortestPath() {
+	for {
+		node, k, ok := p.top()
+		if !ok {
+			break
+		}
+		if !k.less(p.calculateKey(p.start)) && p.rhs[p.start] == p.g[p.start] {
+			break
+		}
+
+		// km only grows over time (via SetStart), so a key computed before the last
+		// bump is stale relative to one computed now. Re-key and requeue rather than
+		// act on it, or nodes can be popped out of true priority order.
+		knew := p.calculateKey(node)
+		if k.less(knew) {
+			p.push(node, knew)
+			continue
+		}
+
+		p.remove(node)
+
+		if p.g[node] > p.rhs[node] {
+			p.g[node] = p.rhs[node]
+			for u := range p.reverse[node] {
+				p.updateVertex(u)
+			}
+		} else {
+			p.g[node] = math.Inf(1)
+			p.updateVertex(node)
+			for u := range p.reverse[node] {
+				p.updateVertex(u)
+			}
+		}
+	}
+}
+
+// extractPath walks from start to goal following the locally cheapest successor at
+// each step
+func (p *Planner) extractPath() (*Result, error) {
+	if math.IsInf(p.g[p.start], 1) {
+		return nil, fmt.Errorf("no path exists from %s to %s", p.start, p.goal)
+	}
+
+	path := []string{p.start}
+	current := p.start
+	for current != p.goal {
+		best := ""
+		bestCost := math.Inf(1)
+		for v, w := range p.graph[current] {
+			if c := w + p.g[v]; c < bestCost {
+				bestCost = c
+				best = v
+			}
+		}
+		if best == "" {
+			return nil, fmt.Errorf("broken path reconstruction from %s to %s", p.start, p.goal)
+		}
+		path = append(path, best)
+		current = best
+	}
+
+	return &Result{
+		Distance: p.g[p.start],
+		Path:     path,
+	}, nil
+}
+
+// Plan computes the initial shortest path from start to goal.
+//
+// Time Complexity: O((V + E) log V) for the first plan
+func (p *Planner) Plan() (*Result, error) {
+	p.computeShortestPath()
+	return p.extractPath()
+}
+
+// Replan recomputes the shortest path after one or more UpdateEdge/SetStart calls,
+// reusing as much of the previous search as possible.
+//
+// Time Complexity: proportional to the number of vertices affected by the changes since
+// the last plan, not the size of the whole graph
+func (p *Planner) Replan() (*Result, error) {
+	p.computeShortestPath()
+	return p.extractPath()
+}
+
+// SetStart moves the agent to newStart, bumping the km key-modifier by the heuristic
+// distance traveled so previously computed keys remain comparable without reheapifying
+// the whole queue.
+func (p *Planner) SetStart(newStart string) {
+	p.km += p.h(p.last, newStart)
+	p.last = newStart
+	p.start = newStart
+}
+
+// UpdateEdge changes the weight of edge (u, v) to newWeight and re-enqueues only the
+// vertices whose shortest-path estimate may be affected by the change.
+func (p *Planner) UpdateEdge(u, v string, newWeight float64) {
+	if _, ok := p.graph[u]; !ok {
+		p.graph[u] = make(map[string]float64)
+	}
+	p.graph[u][v] = newWeight
+
+	if _, ok := p.reverse[v]; !ok {
+		p.reverse[v] = make(map[string]float64)
+	}
+	p.reverse[v][u] = newWeight
+
+	if _, ok := p.g[u]; !ok {
+		p.g[u] = math.Inf(1)
+		p.rhs[u] = math.Inf(1)
+	}
+	if _, ok := p.g[v]; !ok {
+		p.g[v] = math.Inf(1)
+		p.rhs[v] = math.Inf(1)
+	}
+
+	p.updateVertex(u)
+	p.updateVertex(v)
+}
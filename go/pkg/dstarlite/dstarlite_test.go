@@ -0,0 +1,113 @@
+package dstarlite
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func zeroHeuristic(a, b string) float64 { return 0 }
+
+func TestPlan(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	p := New(graph, "A", "D", zeroHeuristic)
+	result, err := p.Plan()
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+
+	if result.Distance != 3 {
+		t.Errorf("Distance: got %v, want 3", result.Distance)
+	}
+
+	expectedPath := []string{"A", "B", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestReplanAfterEdgeUpdate(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1, "C": 4},
+		"B": {"D": 2},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	p := New(graph, "A", "D", zeroHeuristic)
+	if _, err := p.Plan(); err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+
+	// Discover that B->D is far more expensive than expected; the cheaper route now
+	// goes through C.
+	p.UpdateEdge("B", "D", 10)
+
+	result, err := p.Replan()
+	if err != nil {
+		t.Fatalf("Replan failed: %v", err)
+	}
+
+	if result.Distance != 5 {
+		t.Errorf("Distance: got %v, want 5", result.Distance)
+	}
+
+	expectedPath := []string{"A", "C", "D"}
+	if !reflect.DeepEqual(result.Path, expectedPath) {
+		t.Errorf("Path: got %v, want %v", result.Path, expectedPath)
+	}
+}
+
+func TestPlanNoPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {},
+		"C": {},
+	}
+
+	p := New(graph, "A", "C", zeroHeuristic)
+	if _, err := p.Plan(); err == nil {
+		t.Error("Expected error for non-existent path, got nil")
+	}
+}
+
+func TestSetStartBumpsKeyModifier(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {"D": 1},
+		"C": {"D": 1},
+		"D": {},
+	}
+
+	h := func(a, b string) float64 {
+		// Every edge costs 1, so estimating 1 between any two distinct nodes is admissible.
+		if a == b {
+			return 0
+		}
+		return 1
+	}
+
+	p := New(graph, "A", "D", h)
+	if _, err := p.Plan(); err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+
+	p.SetStart("B")
+	if math.IsNaN(p.km) || p.km != 1 {
+		t.Errorf("km: got %v, want h(A, B) = 1 after moving the start from A to B", p.km)
+	}
+
+	result, err := p.Replan()
+	if err != nil {
+		t.Fatalf("Replan failed: %v", err)
+	}
+	if result.Distance != 1 {
+		t.Errorf("Distance: got %v, want 1", result.Distance)
+	}
+}
@@ -0,0 +1,246 @@
+// Package floydwarshall implements the Floyd-Warshall all-pairs shortest path algorithm
+package floydwarshall
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+// Graph represents a weighted directed graph using an adjacency list
+type Graph map[string]map[string]float64
+
+// AllPairsResult contains the all-pairs shortest distances and the data needed to
+// reconstruct a path between any two nodes
+type AllPairsResult struct {
+	Dist      [][]float64
+	Next      [][]int
+	NodeIndex map[string]int
+	nodes     []string
+}
+
+// buildMatrices converts the adjacency-list graph into dense distance/next matrices,
+// indexing nodes in sorted order so results are reproducible across calls
+func buildMatrices(graph Graph) ([][]float64, [][]int, map[string]int, []string) {
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	nodeIndex := make(map[string]int, len(nodes))
+	for i, node := range nodes {
+		nodeIndex[node] = i
+	}
+
+	n := len(nodes)
+	dist := make([][]float64, n)
+	next := make([][]int, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		next[i] = make([]int, n)
+		for j := range dist[i] {
+			if i == j {
+				dist[i][j] = 0
+			} else {
+				dist[i][j] = math.Inf(1)
+			}
+			next[i][j] = -1
+		}
+	}
+
+	for u, edges := range graph {
+		ui := nodeIndex[u]
+		for v, w := range edges {
+			vi := nodeIndex[v]
+			if w < dist[ui][vi] {
+				dist[ui][vi] = w
+				next[ui][vi] = vi
+			}
+		}
+	}
+
+	return dist, next, nodeIndex, nodes
+}
+
+// negativeCycleErr reports the vertices that sit on a detected negative cycle
+func negativeCycleErr(dist [][]float64, nodes []string) error {
+	offending := make([]string, 0)
+	for i := range dist {
+		if dist[i][i] < 0 {
+			offending = append(offending, nodes[i])
+		}
+	}
+	if len(offending) == 0 {
+		return nil
+	}
+	return fmt.Errorf("negative cycle detected involving: %v", offending)
+}
+
+// AllPairs computes all-pairs shortest paths using the classical Floyd-Warshall triple
+// loop.
+//
+// Time Complexity: O(V^3)
+// Space Complexity: O(V^2)
+func AllPairs(graph Graph) (*AllPairsResult, error) {
+	dist, next, nodeIndex, nodes := buildMatrices(graph)
+	n := len(nodes)
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if d := dist[i][k] + dist[k][j]; d < dist[i][j] {
+					dist[i][j] = d
+					next[i][j] = next[i][k]
+				}
+			}
+		}
+	}
+
+	if err := negativeCycleErr(dist, nodes); err != nil {
+		return nil, err
+	}
+
+	return &AllPairsResult{Dist: dist, Next: next, NodeIndex: nodeIndex, nodes: nodes}, nil
+}
+
+// AllPairsParallel computes all-pairs shortest paths using a blocked variant of
+// Floyd-Warshall. The matrix is partitioned into blockSize x blockSize tiles and, for
+// each pivot block, the diagonal tile is relaxed first (it depends only on itself),
+// then the pivot's row and column tiles (which depend only on the diagonal tile), and
+// finally every remaining tile is relaxed in parallel across a pool of workers (each
+// remaining tile depends only on the pivot row/column just computed).
+//
+// Time Complexity: O(V^3), parallelized across workers
+// Space Complexity: O(V^2)
+func AllPairsParallel(graph Graph, blockSize, workers int) (*AllPairsResult, error) {
+	if blockSize <= 0 {
+		return nil, fmt.Errorf("blockSize must be positive")
+	}
+	if workers <= 0 {
+		return nil, fmt.Errorf("workers must be positive")
+	}
+
+	dist, next, nodeIndex, nodes := buildMatrices(graph)
+	n := len(nodes)
+	numBlocks := (n + blockSize - 1) / blockSize
+
+	blockRange := func(b int) (int, int) {
+		lo := b * blockSize
+		hi := lo + blockSize
+		if hi > n {
+			hi = n
+		}
+		return lo, hi
+	}
+
+	relaxTile := func(iLo, iHi, jLo, jHi, kLo, kHi int) {
+		for k := kLo; k < kHi; k++ {
+			for i := iLo; i < iHi; i++ {
+				if math.IsInf(dist[i][k], 1) {
+					continue
+				}
+				for j := jLo; j < jHi; j++ {
+					if d := dist[i][k] + dist[k][j]; d < dist[i][j] {
+						dist[i][j] = d
+						next[i][j] = next[i][k]
+					}
+				}
+			}
+		}
+	}
+
+	for p := 0; p < numBlocks; p++ {
+		kLo, kHi := blockRange(p)
+
+		// Phase 1: the diagonal tile that owns the pivot range.
+		relaxTile(kLo, kHi, kLo, kHi, kLo, kHi)
+
+		// Phase 2: the pivot's row and column tiles, which only depend on the
+		// diagonal tile computed above.
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, workers)
+		for b := 0; b < numBlocks; b++ {
+			if b == p {
+				continue
+			}
+			lo, hi := blockRange(b)
+
+			wg.Add(2)
+			sem <- struct{}{}
+			go func(lo, hi int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				relaxTile(lo, hi, kLo, kHi, kLo, kHi)
+			}(lo, hi)
+
+			sem <- struct{}{}
+			go func(lo, hi int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				relaxTile(kLo, kHi, lo, hi, kLo, kHi)
+			}(lo, hi)
+		}
+		wg.Wait()
+
+		// Phase 3: every remaining tile; each is independent of the others given the
+		// pivot row/column computed in phase 2.
+		for bi := 0; bi < numBlocks; bi++ {
+			if bi == p {
+				continue
+			}
+			iLo, iHi := blockRange(bi)
+			for bj := 0; bj < numBlocks; bj++ {
+				if bj == p {
+					continue
+				}
+				jLo, jHi := blockRange(bj)
+
+				wg.Add(1)
+				sem <- struct{}{}
+				go func(iLo, iHi, jLo, jHi int) {
+					defer wg.Done()
+					defer func() { <-sem }()
+					relaxTile(iLo, iHi, jLo, jHi, kLo, kHi)
+				}(iLo, iHi, jLo, jHi)
+			}
+		}
+		wg.Wait()
+	}
+
+	if err := negativeCycleErr(dist, nodes); err != nil {
+		return nil, err
+	}
+
+	return &AllPairsResult{Dist: dist, Next: next, NodeIndex: nodeIndex, nodes: nodes}, nil
+}
+
+// Path reconstructs the shortest path between u and v from a computed AllPairsResult. It
+// returns nil if either node is unknown or no path exists.
+func (r *AllPairsResult) Path(u, v string) []string {
+	ui, ok := r.NodeIndex[u]
+	if !ok {
+		return nil
+	}
+	vi, ok := r.NodeIndex[v]
+	if !ok {
+		return nil
+	}
+	if ui == vi {
+		return []string{u}
+	}
+	if r.Next[ui][vi] == -1 {
+		return nil
+	}
+
+	path := []string{u}
+	for ui != vi {
+		ui = r.Next[ui][vi]
+		path = append(path, r.nodes[ui])
+	}
+	return path
+}
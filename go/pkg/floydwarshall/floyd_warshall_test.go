@@ -0,0 +1,100 @@
+package floydwarshall
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllPairs(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 4, "C": 2},
+		"B": {"C": 1, "D": 5},
+		"C": {"D": 8},
+		"D": {},
+	}
+
+	result, err := AllPairs(graph)
+	if err != nil {
+		t.Fatalf("AllPairs failed: %v", err)
+	}
+
+	ai, di := result.NodeIndex["A"], result.NodeIndex["D"]
+	if got, want := result.Dist[ai][di], 9.0; got != want {
+		t.Errorf("Dist[A][D]: got %v, want %v", got, want)
+	}
+
+	expectedPath := []string{"A", "B", "D"}
+	if got := result.Path("A", "D"); !reflect.DeepEqual(got, expectedPath) {
+		t.Errorf("Path(A, D): got %v, want %v", got, expectedPath)
+	}
+}
+
+func TestAllPairsParallelMatchesAllPairs(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 4, "C": 2, "E": 7},
+		"B": {"C": 1, "D": 5},
+		"C": {"D": 8, "E": 3},
+		"D": {},
+		"E": {"D": 1},
+	}
+
+	sequential, err := AllPairs(graph)
+	if err != nil {
+		t.Fatalf("AllPairs failed: %v", err)
+	}
+
+	parallel, err := AllPairsParallel(graph, 2, 4)
+	if err != nil {
+		t.Fatalf("AllPairsParallel failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(sequential.Dist, parallel.Dist) {
+		t.Errorf("AllPairsParallel distances diverged from AllPairs: got %v, want %v", parallel.Dist, sequential.Dist)
+	}
+}
+
+func TestAllPairsNegativeCycle(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {"C": -3},
+		"C": {"A": 1},
+	}
+
+	_, err := AllPairs(graph)
+	if err == nil {
+		t.Error("Expected error for negative cycle, got nil")
+	}
+}
+
+func TestAllPairsNoPath(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 1},
+		"B": {},
+		"C": {},
+	}
+
+	result, err := AllPairs(graph)
+	if err != nil {
+		t.Fatalf("AllPairs failed: %v", err)
+	}
+
+	if got := result.Path("A", "C"); got != nil {
+		t.Errorf("Path(A, C): got %v, want nil", got)
+	}
+}
+
+func TestAllPairsSameNode(t *testing.T) {
+	graph := Graph{
+		"A": {"B": 4},
+		"B": {},
+	}
+
+	result, err := AllPairs(graph)
+	if err != nil {
+		t.Fatalf("AllPairs failed: %v", err)
+	}
+
+	if got, want := result.Path("A", "A"), []string{"A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(A, A): got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"iter"
+	"sort"
+)
+
+// CSR is a compressed-sparse-row Graph: outgoing edges are stored as three parallel
+// slices (RowPtr, ColIdx, Weights) indexed by dense integer node ids, with an interned
+// string<->int table so callers can still address nodes by name. This avoids the
+// per-node map allocations of Map and is intended for large graphs
+type CSR struct {
+	RowPtr  []int32
+	ColIdx  []int32
+	Weights []float64
+
+	ids   []string
+	index map[string]int
+}
+
+// NewCSR builds a CSR store from an adjacency-list graph, interning each node name to a
+// dense integer id in sorted order for reproducible indices
+func NewCSR(g Map) *CSR {
+	ids := make([]string, 0, len(g))
+	for node := range g {
+		ids = append(ids, node)
+	}
+	sort.Strings(ids)
+
+	index := make(map[string]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	rowPtr := make([]int32, len(ids)+1)
+	colIdx := make([]int32, 0)
+	weights := make([]float64, 0)
+
+	for i, id := range ids {
+		rowPtr[i] = int32(len(colIdx))
+
+		neighbors := make([]string, 0, len(g[id]))
+		for v := range g[id] {
+			neighbors = append(neighbors, v)
+		}
+		sort.Strings(neighbors)
+
+		for _, v := range neighbors {
+			colIdx = append(colIdx, int32(index[v]))
+			weights = append(weights, g[id][v])
+		}
+	}
+	rowPtr[len(ids)] = int32(len(colIdx))
+
+	return &CSR{
+		RowPtr:  rowPtr,
+		ColIdx:  colIdx,
+		Weights: weights,
+		ids:     ids,
+		index:   index,
+	}
+}
+
+// Nodes implements Graph
+func (c *CSR) Nodes() iter.Seq[NodeID] {
+	return func(yield func(NodeID) bool) {
+		for _, id := range c.ids {
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors implements Graph
+func (c *CSR) Neighbors(node NodeID) iter.Seq2[NodeID, float64] {
+	return func(yield func(NodeID, float64) bool) {
+		i, ok := c.index[node]
+		if !ok {
+			return
+		}
+		for e := c.RowPtr[i]; e < c.RowPtr[i+1]; e++ {
+			if !yield(c.ids[c.ColIdx[e]], c.Weights[e]) {
+				return
+			}
+		}
+	}
+}
+
+// NumNodes implements Graph
+func (c *CSR) NumNodes() int { return len(c.ids) }
+
+// NodeIndex implements Graph in O(1) using the interned id table built by NewCSR
+func (c *CSR) NodeIndex(node NodeID) int {
+	if i, ok := c.index[node]; ok {
+		return i
+	}
+	return -1
+}
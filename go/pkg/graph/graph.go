@@ -0,0 +1,60 @@
+// Package graph defines the storage-agnostic Graph abstraction shared by this module's
+// search algorithms, along with two backends: Map (the original adjacency-list map) and
+// CSR (a compressed-sparse-row store for large graphs)
+package graph
+
+import "iter"
+
+// NodeID identifies a vertex. It is a plain string alias so existing string-keyed call
+// sites (node names, map literals) keep working unchanged
+type NodeID = string
+
+// Graph is the abstraction search algorithms are written against, so the same
+// Dijkstra/A*/Bellman-Ford code can run over a plain adjacency-list map or a compact
+// compressed-sparse-row store without depending on either directly
+type Graph interface {
+	// Nodes iterates over every node in the graph, in implementation-defined order
+	Nodes() iter.Seq[NodeID]
+	// Neighbors iterates over the outgoing edges of node, yielding each neighbor and
+	// the weight of the edge leading to it
+	Neighbors(node NodeID) iter.Seq2[NodeID, float64]
+	// NumNodes returns the total number of nodes in the graph
+	NumNodes() int
+	// NodeIndex returns the dense, 0-based integer index assigned to node, or -1 if
+	// node is not part of the graph
+	NodeIndex(node NodeID) int
+}
+
+// Index returns g's nodes as a dense slice, along with a lookup function mapping a node
+// name back to its position in that slice. For CSR the slice is ordered by NodeIndex;
+// for any other backend it follows Nodes() order, so lookup need not agree with
+// g.NodeIndex (for Map, NodeIndex is the sorted position). Search algorithms that keep their
+// bookkeeping in int-indexed slices should call this once per query instead of calling
+// NodeIndex per lookup or building their own name->index map: for CSR, whose NodeIndex
+// is already O(1) against an interned table, lookup is CSR's own NodeIndex, so no
+// redundant map is built; for Map, whose NodeIndex recomputes a sorted order on every
+// call, lookup is backed by a map built once here.
+func Index(g Graph) (nodes []NodeID, lookup func(NodeID) int) {
+	n := g.NumNodes()
+
+	if csr, ok := g.(*CSR); ok {
+		nodes = make([]NodeID, n)
+		for node := range g.Nodes() {
+			nodes[csr.NodeIndex(node)] = node
+		}
+		return nodes, csr.NodeIndex
+	}
+
+	nodes = make([]NodeID, 0, n)
+	index := make(map[NodeID]int, n)
+	for node := range g.Nodes() {
+		index[node] = len(nodes)
+		nodes = append(nodes, node)
+	}
+	return nodes, func(node NodeID) int {
+		if i, ok := index[node]; ok {
+			return i
+		}
+		return -1
+	}
+}
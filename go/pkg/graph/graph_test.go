@@ -0,0 +1,67 @@
+package graph
+
+import "testing"
+
+func TestMapImplementsGraph(t *testing.T) {
+	m := Map{
+		"A": {"B": 1, "C": 4},
+		"B": {"C": 2},
+		"C": {},
+	}
+
+	var g Graph = m
+	if got, want := g.NumNodes(), 3; got != want {
+		t.Errorf("NumNodes: got %v, want %v", got, want)
+	}
+
+	neighbors := make(map[string]float64)
+	for v, w := range g.Neighbors("A") {
+		neighbors[v] = w
+	}
+	if len(neighbors) != 2 || neighbors["B"] != 1 || neighbors["C"] != 4 {
+		t.Errorf("Neighbors(A): got %v, want {B:1 C:4}", neighbors)
+	}
+}
+
+func TestCSRMatchesMap(t *testing.T) {
+	m := Map{
+		"A": {"B": 1, "C": 4},
+		"B": {"C": 2},
+		"C": {},
+	}
+
+	csr := NewCSR(m)
+	var g Graph = csr
+
+	if got, want := g.NumNodes(), m.NumNodes(); got != want {
+		t.Errorf("NumNodes: got %v, want %v", got, want)
+	}
+
+	for _, node := range []string{"A", "B", "C"} {
+		mapNeighbors := make(map[string]float64)
+		for v, w := range m.Neighbors(node) {
+			mapNeighbors[v] = w
+		}
+
+		csrNeighbors := make(map[string]float64)
+		for v, w := range g.Neighbors(node) {
+			csrNeighbors[v] = w
+		}
+
+		if len(mapNeighbors) != len(csrNeighbors) {
+			t.Fatalf("Neighbors(%s): got %v, want %v", node, csrNeighbors, mapNeighbors)
+		}
+		for v, w := range mapNeighbors {
+			if csrNeighbors[v] != w {
+				t.Errorf("Neighbors(%s)[%s]: got %v, want %v", node, v, csrNeighbors[v], w)
+			}
+		}
+	}
+
+	if g.NodeIndex("B") == -1 {
+		t.Error("NodeIndex(B): got -1, want a valid index")
+	}
+	if g.NodeIndex("Z") != -1 {
+		t.Errorf("NodeIndex(Z): got %v, want -1", g.NodeIndex("Z"))
+	}
+}
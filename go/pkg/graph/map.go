@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"iter"
+	"sort"
+)
+
+// Map is a Graph backed by the conventional map[string]map[string]float64 adjacency
+// list, kept so the original algorithm packages remain source-compatible
+type Map map[NodeID]map[NodeID]float64
+
+// Nodes implements Graph
+func (m Map) Nodes() iter.Seq[NodeID] {
+	return func(yield func(NodeID) bool) {
+		for node := range m {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors implements Graph
+func (m Map) Neighbors(node NodeID) iter.Seq2[NodeID, float64] {
+	return func(yield func(NodeID, float64) bool) {
+		for v, w := range m[node] {
+			if !yield(v, w) {
+				return
+			}
+		}
+	}
+}
+
+// NumNodes implements Graph
+func (m Map) NumNodes() int { return len(m) }
+
+// NodeIndex implements Graph by computing node's position in the lexicographically
+// sorted node list. Map keeps no index table, so callers on a hot path should build
+// their own via Nodes() once rather than calling NodeIndex per lookup
+func (m Map) NodeIndex(node NodeID) int {
+	if _, ok := m[node]; !ok {
+		return -1
+	}
+	nodes := make([]string, 0, len(m))
+	for n := range m {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+	for i, n := range nodes {
+		if n == node {
+			return i
+		}
+	}
+	return -1
+}